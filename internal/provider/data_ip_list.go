@@ -3,13 +3,67 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var ipListSchema = map[string]*schema.Schema{
+	"filter_clusters": {
+		Description: "Only return IPs belonging to these clusters. When empty, IPs from all clusters are returned.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"output_format": {
+		Description: "The format `ips` is returned in. Available values: `ips` (flat list of addresses, default), `cidrs` (minimal CIDR blocks covering both IPv4 and IPv6 addresses), `cidrs_ipv4` and `cidrs_ipv6` (minimal CIDR blocks for a single address family).",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "ips",
+		ValidateFunc: validation.StringInSlice([]string{
+			"ips",
+			"cidrs",
+			"cidrs_ipv4",
+			"cidrs_ipv6",
+		}, false),
+	},
+	"refresh_interval": {
+		Description: "Minimum number of seconds between lookups. When set, a cached response younger than this interval is reused instead of querying the API again on `terraform refresh`.",
+		Type:        schema.TypeInt,
+		Optional:    true,
+	},
+	"last_refreshed": {
+		Description: "RFC3339 timestamp of the last time this data source fetched fresh data from the API.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"ips": {
+		Description: "The IPs (or CIDR blocks, depending on `output_format`), filtered by `filter_clusters` if set.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"all_clusters": {
+		Description: "All available cluster names, regardless of `filter_clusters`.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"cluster_ips": {
+		Description: "A map of cluster name to its raw list of IPs, filtered by `filter_clusters` if set.",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+	},
+}
+
 func newIpListDataSource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: ipListLookup,
@@ -19,6 +73,19 @@ func newIpListDataSource() *schema.Resource {
 }
 
 func ipListLookup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	configChanged := d.HasChange("filter_clusters") || d.HasChange("output_format") || d.HasChange("refresh_interval")
+	if interval, ok := d.GetOk("refresh_interval"); ok && !configChanged {
+		if last, ok := d.GetOk("last_refreshed"); ok {
+			if lastRefreshed, err := time.Parse(time.RFC3339, last.(string)); err == nil {
+				if time.Since(lastRefreshed) < time.Duration(interval.(int))*time.Second {
+					// Cached response is still fresh and the inputs that shaped
+					// it are unchanged; keep the existing state.
+					return nil
+				}
+			}
+		}
+	}
+
 	res, err := meta.(*client).Get(ctx, "/ips-by-cluster.json")
 	if err != nil {
 		return diag.FromErr(err)
@@ -53,21 +120,152 @@ func ipListLookup(ctx context.Context, d *schema.ResourceData, meta interface{})
 	// Filter IPs based on the specified clusters, and fetch all clusters
 	var filteredIPs []string
 	var allClusters []string
+	clusterIPs := make(map[string]interface{})
 	for cluster, ips := range ipData {
 		if len(filterClusters) == 0 || filterClusters[cluster] {
 			filteredIPs = append(filteredIPs, ips...)
+			clusterIPs[cluster] = ips
 		}
 		allClusters = append(allClusters, cluster)
 	}
 
+	outputIPs, err := formatIPs(filteredIPs, d.Get("output_format").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Set the data in the Terraform schema
 	d.SetId("betterstack_ip_list")
-	if err := d.Set("ips", filteredIPs); err != nil {
+	if err := d.Set("ips", outputIPs); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("all_clusters", allClusters); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("cluster_ips", clusterIPs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("last_refreshed", time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
+
+// formatIPs renders ips according to outputFormat, aggregating into minimal
+// CIDR blocks when a cidrs* format is requested.
+func formatIPs(ips []string, outputFormat string) ([]string, error) {
+	switch outputFormat {
+	case "ips", "":
+		return ips, nil
+	case "cidrs":
+		cidrs := append(aggregateCIDRs(ips, true), aggregateCIDRs(ips, false)...)
+		sort.Strings(cidrs)
+		return cidrs, nil
+	case "cidrs_ipv4":
+		return aggregateCIDRs(ips, true), nil
+	case "cidrs_ipv6":
+		return aggregateCIDRs(ips, false), nil
+	default:
+		return nil, fmt.Errorf("unknown output_format %q", outputFormat)
+	}
+}
+
+// cidrTrieNode is a node of a binary trie over IP address bits, used to
+// aggregate addresses into the minimal set of CIDR blocks that cover them.
+type cidrTrieNode struct {
+	children [2]*cidrTrieNode
+	full     bool
+}
+
+func cidrTrieInsert(root *cidrTrieNode, ip net.IP, bits int) {
+	node := root
+	for i := 0; i < bits; i++ {
+		bit := (ip[i/8] >> (7 - uint(i%8))) & 1
+		if node.children[bit] == nil {
+			node.children[bit] = &cidrTrieNode{}
+		}
+		node = node.children[bit]
+	}
+	node.full = true
+}
+
+// cidrTrieCollapse walks the trie bottom-up, marking an interior node full
+// (and dropping its children) whenever both of its children are full.
+func cidrTrieCollapse(node *cidrTrieNode) bool {
+	if node == nil {
+		return false
+	}
+	if node.children[0] == nil && node.children[1] == nil {
+		return node.full
+	}
+	leftFull := cidrTrieCollapse(node.children[0])
+	rightFull := cidrTrieCollapse(node.children[1])
+	if leftFull && rightFull {
+		node.full = true
+		node.children[0] = nil
+		node.children[1] = nil
+	}
+	return node.full
+}
+
+// cidrTrieEmit walks the collapsed trie and appends the covering prefixes to
+// out in canonical (address, then length) order.
+func cidrTrieEmit(node *cidrTrieNode, prefix net.IP, depth, bits int, out *[]string) {
+	if node == nil {
+		return
+	}
+	if node.full {
+		*out = append(*out, fmt.Sprintf("%s/%d", prefix.String(), depth))
+		return
+	}
+	for bit := 0; bit < 2; bit++ {
+		child := node.children[bit]
+		if child == nil {
+			continue
+		}
+		next := append(net.IP(nil), prefix...)
+		if bit == 1 {
+			next[depth/8] |= 1 << (7 - uint(depth%8))
+		}
+		cidrTrieEmit(child, next, depth+1, bits, out)
+	}
+}
+
+// aggregateCIDRs coalesces ips (a mix of IPv4 and IPv6 addresses) belonging
+// to a single family into the minimal list of CIDR blocks covering them.
+func aggregateCIDRs(ips []string, ipv4 bool) []string {
+	bits, byteLen := 128, 16
+	if ipv4 {
+		bits, byteLen = 32, 4
+	}
+
+	root := &cidrTrieNode{}
+	inserted := 0
+	for _, raw := range ips {
+		ip := net.ParseIP(raw)
+		if ip == nil {
+			continue
+		}
+		ip4 := ip.To4()
+		if ipv4 != (ip4 != nil) {
+			continue
+		}
+		if ipv4 {
+			cidrTrieInsert(root, ip4, bits)
+		} else {
+			cidrTrieInsert(root, ip.To16(), bits)
+		}
+		inserted++
+	}
+	if inserted == 0 {
+		return nil
+	}
+
+	cidrTrieCollapse(root)
+
+	var out []string
+	cidrTrieEmit(root, make(net.IP, byteLen), 0, bits, &out)
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// nestedBlockField describes one field of a tf-tagged struct, as discovered
+// by nestedBlockFields: the attribute name it corresponds to in the nested
+// block's schema, how it should be expanded/flattened, and the reflected
+// struct field itself.
+//
+// Fields opt in via a `tf:"name[,mod]"` struct tag; fields without one are
+// left untouched. Supported modifiers:
+//
+//   - (none): a scalar attribute (string/int/bool), stored as a pointer or
+//     in an interface{} field.
+//   - "sensitive": same as a scalar attribute; documents that the
+//     corresponding schema field is marked Sensitive.
+//   - "headers": a `{name, value}` list, stored as *[]headerTemplate so an
+//     explicitly-cleared list (non-nil, empty) can be told apart from one
+//     that was never touched (nil).
+//   - "nested": another tf-tagged struct, stored as a pointer, expanded or
+//     flattened recursively.
+//
+// When the tag's name segment is omitted (e.g. `tf:",sensitive"`), the
+// attribute name is taken from the field's `json` tag instead.
+type nestedBlockField struct {
+	name     string
+	modifier string
+	value    reflect.Value
+}
+
+func nestedBlockFields(target reflect.Value) []nestedBlockField {
+	rt := target.Type()
+	var fields []nestedBlockField
+	for i := 0; i < rt.NumField(); i++ {
+		tag, ok := rt.Field(i).Tag.Lookup("tf")
+		if !ok {
+			continue
+		}
+		name, modifier, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name, _, _ = strings.Cut(rt.Field(i).Tag.Get("json"), ",")
+		}
+		fields = append(fields, nestedBlockField{name: name, modifier: modifier, value: target.Field(i)})
+	}
+	return fields
+}
+
+// expandNestedBlock populates target (a pointer to a tf-tagged struct) from
+// the single nested block configured under key. If the block is absent,
+// target is left unmodified.
+func expandNestedBlock(d *schema.ResourceData, key string, target interface{}) error {
+	v, ok := d.GetOk(key)
+	if !ok {
+		return nil
+	}
+	list := v.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	return populateNestedBlock(d, key, list[0].(map[string]interface{}), target)
+}
+
+// populateNestedBlock fills target's tf-tagged fields from attrs, the
+// single configured instance of the nested block addressed by blockKey
+// (e.g. "custom_webhook_template_attributes" or, recursively,
+// "delivery.0.on_delivery_failure"). blockKey is threaded through so
+// "headers" fields can consult d.HasChange on their own sub-attribute path.
+func populateNestedBlock(d *schema.ResourceData, blockKey string, attrs map[string]interface{}, target interface{}) error {
+	rv := reflect.ValueOf(target).Elem()
+	for _, f := range nestedBlockFields(rv) {
+		raw, ok := attrs[f.name]
+		if !ok {
+			continue
+		}
+
+		switch f.modifier {
+		case "headers":
+			headers, ok := raw.([]interface{})
+			if !ok {
+				continue
+			}
+			fieldKey := blockKey + ".0." + f.name
+			if len(headers) == 0 && !d.HasChange(fieldKey) {
+				// Never configured (or unchanged): leave target's field nil
+				// so it's omitted from the request rather than sent as an
+				// explicit clear.
+				continue
+			}
+			expanded := expandHeaderTemplate(headers)
+			f.value.Set(reflect.ValueOf(&expanded))
+		case "nested":
+			nested, ok := raw.([]interface{})
+			if !ok || len(nested) == 0 || nested[0] == nil {
+				continue
+			}
+			elem := reflect.New(f.value.Type().Elem())
+			if err := populateNestedBlock(d, blockKey+".0."+f.name, nested[0].(map[string]interface{}), elem.Interface()); err != nil {
+				return err
+			}
+			f.value.Set(elem)
+		default:
+			rawVal := reflect.ValueOf(raw)
+			if !rawVal.IsValid() || rawVal.IsZero() {
+				// The zero value (""/0/false) is indistinguishable from "not
+				// set in config" - skip it rather than sending it to the API.
+				// An explicit zero in config is therefore not sent either.
+				continue
+			}
+			if f.value.Kind() == reflect.Interface {
+				f.value.Set(rawVal)
+				continue
+			}
+			if !rawVal.Type().AssignableTo(f.value.Type().Elem()) {
+				return fmt.Errorf("nested block field %q: cannot assign %T to %s", f.name, raw, f.value.Type())
+			}
+			ptr := reflect.New(f.value.Type().Elem())
+			ptr.Elem().Set(rawVal)
+			f.value.Set(ptr)
+		}
+	}
+	return nil
+}
+
+// flattenNestedBlock renders a tf-tagged struct (or pointer to one) back
+// into the map[string]interface{} shape Terraform state expects for a
+// single nested block. It returns nil for a nil pointer.
+func flattenNestedBlock(src interface{}) map[string]interface{} {
+	rv := reflect.ValueOf(src)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	out := make(map[string]interface{})
+	for _, f := range nestedBlockFields(rv) {
+		switch f.modifier {
+		case "headers":
+			headersPtr, ok := f.value.Interface().(*[]headerTemplate)
+			if !ok || headersPtr == nil {
+				continue
+			}
+			out[f.name] = flattenHeaderTemplate(*headersPtr)
+		case "nested":
+			if f.value.IsNil() {
+				continue
+			}
+			if nested := flattenNestedBlock(f.value.Interface()); nested != nil {
+				out[f.name] = []interface{}{nested}
+			}
+		default:
+			out[f.name] = f.value.Interface()
+		}
+	}
+	return out
+}
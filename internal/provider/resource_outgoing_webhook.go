@@ -65,8 +65,55 @@ var outgoingWebhookSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Default:     false,
 	},
+	"on_on_call_shift_started": {
+		Description: "Whether to trigger webhook when an on-call shift starts. Only when `trigger_type=on_call_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"on_on_call_shift_ended": {
+		Description: "Whether to trigger webhook when an on-call shift ends. Only when `trigger_type=on_call_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"on_on_call_override_created": {
+		Description: "Whether to trigger webhook when an on-call override is created. Only when `trigger_type=on_call_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"on_monitor_created": {
+		Description: "Whether to trigger webhook when a monitor is created. Only when `trigger_type=monitor_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"on_monitor_updated": {
+		Description: "Whether to trigger webhook when a monitor is updated. Only when `trigger_type=monitor_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"on_monitor_deleted": {
+		Description: "Whether to trigger webhook when a monitor is deleted. Only when `trigger_type=monitor_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"on_monitor_paused": {
+		Description: "Whether to trigger webhook when a monitor is paused. Only when `trigger_type=monitor_change`.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"template_id": {
+		Description: "The ID of a `betterstack_webhook_template` to render the request body and headers from. When set, `custom_webhook_template_attributes` is optional and the template is resolved server-side.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
 	"custom_webhook_template_attributes": {
-		Description: "Custom webhook template configuration.",
+		Description: "Custom webhook template configuration. Not required when `template_id` is set.",
 		Type:        schema.TypeList,
 		Optional:    true,
 		MaxItems:    1,
@@ -77,44 +124,153 @@ var outgoingWebhookSchema = map[string]*schema.Schema{
 					Computed: true,
 				},
 				"http_method": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "post",
-					Description: "The HTTP method to use when sending the webhook. Possible values: `get`, `post`, `put`, `patch` and `head`.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "post",
+					DiffSuppressFunc: suppressWhenTemplateBound,
+					Description:      "The HTTP method to use when sending the webhook. Possible values: `get`, `post`, `put`, `patch` and `head`.",
 				},
 				"auth_username": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "The username to use for basic authentication.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: suppressWhenTemplateBound,
+					Description:      "The username to use for basic authentication.",
 				},
 				"auth_password": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Sensitive:   true,
-					Description: "The password to use for basic authentication.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Sensitive:        true,
+					DiffSuppressFunc: suppressWhenTemplateBound,
+					Description:      "The password to use for basic authentication.",
 				},
 				"headers_template": {
-					Type:        schema.TypeList,
-					Optional:    true,
-					Description: "The headers to include in the webhook request.",
+					Type:             schema.TypeList,
+					Optional:         true,
+					DiffSuppressFunc: suppressWhenTemplateBound,
+					Description:      "The headers to include in the webhook request.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"name": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppressWhenTemplateBound,
 							},
 							"value": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:             schema.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppressWhenTemplateBound,
 							},
 						},
 					},
 				},
 				"body_template": {
-					Type:             schema.TypeString,
-					Optional:         true,
-					DiffSuppressFunc: suppressEquivalentJSONDiffs,
-					Description:      "The body of the webhook request.",
+					Type:     schema.TypeString,
+					Optional: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						return suppressWhenTemplateBound(k, old, new, d) || suppressEquivalentJSONDiffs(k, old, new, d)
+					},
+					Description: "The body of the webhook request.",
+				},
+				"signing": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "HMAC signing configuration for the outgoing webhook request.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"algorithm": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The HMAC algorithm to use to sign the request body. Available values: `sha256`, `sha512`.",
+								ValidateFunc: validation.StringInSlice([]string{
+									"sha256",
+									"sha512",
+								}, false),
+							},
+							"secret": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								Description: "The secret used to compute the HMAC of the rendered body.",
+							},
+							"header_name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "X-Signature",
+								Description: "The name of the header the computed signature is sent in.",
+							},
+							"signature_prefix": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "A prefix prepended to the computed signature, e.g. `sha256=`.",
+							},
+							"timestamp_header": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "When set, the current timestamp is sent in this header and included in the signed payload, e.g. `t=…,v1=…`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	"delivery": {
+		Description: "Delivery retry and failure alerting configuration. One set of settings applies to all events sent by this webhook.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The number of times to retry a failed delivery before giving up.",
+				},
+				"retry_backoff": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The backoff strategy between retries. Available values: `linear`, `exponential`.",
+					ValidateFunc: validation.StringInSlice([]string{
+						"linear",
+						"exponential",
+					}, false),
+				},
+				"initial_delay_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The delay before the first retry, in seconds.",
+				},
+				"max_delay_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The maximum delay between retries, in seconds.",
+				},
+				"timeout_seconds": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The time to wait for the receiving endpoint to respond before considering the delivery attempt failed.",
+				},
+				"on_delivery_failure": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Create an incident after consecutive delivery failures.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"policy_id": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The ID of the escalation policy to create the incident on.",
+							},
+							"consecutive_failures": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     1,
+								Description: "The number of consecutive delivery failures after which an incident is created.",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -126,13 +282,36 @@ type headerTemplate struct {
 	Value string `json:"value"`
 }
 
+type webhookSigning struct {
+	Algorithm       *string `json:"algorithm,omitempty" tf:"algorithm"`
+	Secret          *string `json:"secret,omitempty" tf:",sensitive"`
+	HeaderName      *string `json:"header_name,omitempty" tf:"header_name"`
+	SignaturePrefix *string `json:"signature_prefix,omitempty" tf:"signature_prefix"`
+	TimestampHeader *string `json:"timestamp_header,omitempty" tf:"timestamp_header"`
+}
+
 type customWebhookTemplateAttributes struct {
-	ID             *string          `json:"id,omitempty"`
-	HTTPMethod     *string          `json:"http_method,omitempty"`
-	AuthUsername   *string          `json:"auth_username,omitempty"`
-	AuthPassword   *string          `json:"auth_password,omitempty"`
-	HeaderTemplate []headerTemplate `json:"headers_template,omitempty"`
-	BodyTemplate   interface{}      `json:"body_template,omitempty"`
+	ID             *string           `json:"id,omitempty" tf:"id"`
+	HTTPMethod     *string           `json:"http_method,omitempty" tf:"http_method"`
+	AuthUsername   *string           `json:"auth_username,omitempty" tf:"auth_username"`
+	AuthPassword   *string           `json:"auth_password,omitempty" tf:",sensitive"`
+	HeaderTemplate *[]headerTemplate `json:"headers_template,omitempty" tf:",headers"`
+	BodyTemplate   interface{}       `json:"body_template,omitempty" tf:"body_template"`
+	Signing        *webhookSigning   `json:"signing,omitempty" tf:"signing,nested"`
+}
+
+type onDeliveryFailure struct {
+	PolicyID            *string `json:"policy_id,omitempty" tf:"policy_id"`
+	ConsecutiveFailures *int    `json:"consecutive_failures,omitempty" tf:"consecutive_failures"`
+}
+
+type webhookDelivery struct {
+	MaxRetries          *int               `json:"max_retries,omitempty" tf:"max_retries"`
+	RetryBackoff        *string            `json:"retry_backoff,omitempty" tf:"retry_backoff"`
+	InitialDelaySeconds *int               `json:"initial_delay_seconds,omitempty" tf:"initial_delay_seconds"`
+	MaxDelaySeconds     *int               `json:"max_delay_seconds,omitempty" tf:"max_delay_seconds"`
+	TimeoutSeconds      *int               `json:"timeout_seconds,omitempty" tf:"timeout_seconds"`
+	OnDeliveryFailure   *onDeliveryFailure `json:"on_delivery_failure,omitempty" tf:"on_delivery_failure,nested"`
 }
 
 type outgoingWebhook struct {
@@ -143,7 +322,16 @@ type outgoingWebhook struct {
 	OnIncidentStarted               *bool                            `json:"on_incident_started,omitempty"`
 	OnIncidentAcknowledged          *bool                            `json:"on_incident_acknowledged,omitempty"`
 	OnIncidentResolved              *bool                            `json:"on_incident_resolved,omitempty"`
+	OnOnCallShiftStarted            *bool                            `json:"on_on_call_shift_started,omitempty"`
+	OnOnCallShiftEnded              *bool                            `json:"on_on_call_shift_ended,omitempty"`
+	OnOnCallOverrideCreated         *bool                            `json:"on_on_call_override_created,omitempty"`
+	OnMonitorCreated                *bool                            `json:"on_monitor_created,omitempty"`
+	OnMonitorUpdated                *bool                            `json:"on_monitor_updated,omitempty"`
+	OnMonitorDeleted                *bool                            `json:"on_monitor_deleted,omitempty"`
+	OnMonitorPaused                 *bool                            `json:"on_monitor_paused,omitempty"`
 	CustomWebhookTemplateAttributes *customWebhookTemplateAttributes `json:"custom_webhook_template_attributes,omitempty"`
+	TemplateID                      *string                          `json:"template_id,omitempty"`
+	Delivery                        *webhookDelivery                 `json:"delivery,omitempty"`
 	TeamName                        *string                          `json:"team_name,omitempty"`
 }
 
@@ -154,16 +342,33 @@ type outgoingWebhookHTTPResponse struct {
 	} `json:"data"`
 }
 
+// suppressWhenTemplateBound suppresses diffs on custom_webhook_template_attributes
+// sub-fields when the webhook is bound to a template_id. A DiffSuppressFunc on
+// the list itself would only cover its count key, not these nested attributes, so
+// each field that can be rendered by a template needs this individually to
+// avoid fighting the template's server-side body/headers.
+func suppressWhenTemplateBound(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("template_id").(string) != ""
+}
+
+// outgoingWebhookTriggerFields maps each trigger_type to the set of
+// sub-event boolean fields that are only meaningful for it.
+var outgoingWebhookTriggerFields = map[string][]string{
+	"incident_change": {"on_incident_started", "on_incident_acknowledged", "on_incident_resolved"},
+	"on_call_change":  {"on_on_call_shift_started", "on_on_call_shift_ended", "on_on_call_override_created"},
+	"monitor_change":  {"on_monitor_created", "on_monitor_updated", "on_monitor_deleted", "on_monitor_paused"},
+}
+
 func validateOutgoingWebhook(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
 	triggerType := d.Get("trigger_type").(string)
 
-	// Validate incident_change specific fields
-	incidentFields := []string{"on_incident_started", "on_incident_acknowledged", "on_incident_resolved"}
-
-	for _, field := range incidentFields {
-		if value, ok := d.GetOk(field); ok && value.(bool) {
-			if triggerType != "incident_change" {
-				return fmt.Errorf("%s can only be set when trigger_type is 'incident_change'", field)
+	for ownerTriggerType, fields := range outgoingWebhookTriggerFields {
+		if ownerTriggerType == triggerType {
+			continue
+		}
+		for _, field := range fields {
+			if value, ok := d.GetOk(field); ok && value.(bool) {
+				return fmt.Errorf("%s can only be set when trigger_type is '%s'", field, ownerTriggerType)
 			}
 		}
 	}
@@ -198,10 +403,12 @@ func outgoingWebhookRef(in *outgoingWebhook, triggerType string) []struct {
 		{k: "name", v: &in.Name},
 		{k: "url", v: &in.URL},
 		{k: "trigger_type", v: &in.TriggerType},
+		{k: "template_id", v: &in.TemplateID},
 	}
 
-	// Only include incident-related fields if trigger_type is incident_change
-	if triggerType == "incident_change" {
+	// Only include the sub-event fields that belong to this trigger_type
+	switch triggerType {
+	case "incident_change":
 		refs = append(refs, []struct {
 			k string
 			v interface{}
@@ -210,6 +417,25 @@ func outgoingWebhookRef(in *outgoingWebhook, triggerType string) []struct {
 			{k: "on_incident_acknowledged", v: &in.OnIncidentAcknowledged},
 			{k: "on_incident_resolved", v: &in.OnIncidentResolved},
 		}...)
+	case "on_call_change":
+		refs = append(refs, []struct {
+			k string
+			v interface{}
+		}{
+			{k: "on_on_call_shift_started", v: &in.OnOnCallShiftStarted},
+			{k: "on_on_call_shift_ended", v: &in.OnOnCallShiftEnded},
+			{k: "on_on_call_override_created", v: &in.OnOnCallOverrideCreated},
+		}...)
+	case "monitor_change":
+		refs = append(refs, []struct {
+			k string
+			v interface{}
+		}{
+			{k: "on_monitor_created", v: &in.OnMonitorCreated},
+			{k: "on_monitor_updated", v: &in.OnMonitorUpdated},
+			{k: "on_monitor_deleted", v: &in.OnMonitorDeleted},
+			{k: "on_monitor_paused", v: &in.OnMonitorPaused},
+		}...)
 	}
 	return refs
 }
@@ -226,39 +452,20 @@ func outgoingWebhookCreate(ctx context.Context, d *schema.ResourceData, meta int
 	// Load team name
 	load(d, "team_name", &in.TeamName)
 
-	// Handle custom webhook template attributes
-	if v, ok := d.GetOk("custom_webhook_template_attributes"); ok && len(v.([]interface{})) > 0 {
-		attrs := v.([]interface{})[0].(map[string]interface{})
-		template := &customWebhookTemplateAttributes{}
-
-		if method, ok := attrs["http_method"].(string); ok {
-			template.HTTPMethod = &method
-		}
-		if user, ok := attrs["auth_username"].(string); ok {
-			template.AuthUsername = &user
-		}
-		if pass, ok := attrs["auth_password"].(string); ok {
-			template.AuthPassword = &pass
-		}
-
-		// Handle headers template
-		if headers, ok := attrs["headers_template"].([]interface{}); ok {
-			template.HeaderTemplate = make([]headerTemplate, len(headers))
-			for i, h := range headers {
-				header := h.(map[string]interface{})
-				template.HeaderTemplate[i] = headerTemplate{
-					Name:  header["name"].(string),
-					Value: header["value"].(string),
-				}
-			}
+	// Load delivery settings
+	if v, ok := d.GetOk("delivery"); ok && len(v.([]interface{})) > 0 {
+		in.Delivery = &webhookDelivery{}
+		if err := expandNestedBlock(d, "delivery", in.Delivery); err != nil {
+			return diag.FromErr(err)
 		}
+	}
 
-		// Handle body template
-		if body, ok := attrs["body_template"].(string); ok {
-			template.BodyTemplate = body
+	// Handle custom webhook template attributes
+	if v, ok := d.GetOk("custom_webhook_template_attributes"); ok && len(v.([]interface{})) > 0 {
+		in.CustomWebhookTemplateAttributes = &customWebhookTemplateAttributes{}
+		if err := expandNestedBlock(d, "custom_webhook_template_attributes", in.CustomWebhookTemplateAttributes); err != nil {
+			return diag.FromErr(err)
 		}
-
-		in.CustomWebhookTemplateAttributes = template
 	}
 
 	var out outgoingWebhookHTTPResponse
@@ -296,27 +503,14 @@ func outgoingWebhookCopyAttrs(d *schema.ResourceData, in *outgoingWebhook) diag.
 	}
 
 	// Handle custom webhook template attributes
-	if in.CustomWebhookTemplateAttributes != nil {
-		template := map[string]interface{}{
-			"id":            in.CustomWebhookTemplateAttributes.ID,
-			"http_method":   in.CustomWebhookTemplateAttributes.HTTPMethod,
-			"auth_username": in.CustomWebhookTemplateAttributes.AuthUsername,
-			"auth_password": in.CustomWebhookTemplateAttributes.AuthPassword,
-			"body_template": in.CustomWebhookTemplateAttributes.BodyTemplate,
-		}
-
-		if len(in.CustomWebhookTemplateAttributes.HeaderTemplate) > 0 {
-			headers := make([]map[string]interface{}, len(in.CustomWebhookTemplateAttributes.HeaderTemplate))
-			for i, h := range in.CustomWebhookTemplateAttributes.HeaderTemplate {
-				headers[i] = map[string]interface{}{
-					"name":  h.Name,
-					"value": h.Value,
-				}
-			}
-			template["headers_template"] = headers
+	if template := flattenNestedBlock(in.CustomWebhookTemplateAttributes); template != nil {
+		if err := d.Set("custom_webhook_template_attributes", []interface{}{template}); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
 		}
+	}
 
-		if err := d.Set("custom_webhook_template_attributes", []interface{}{template}); err != nil {
+	if delivery := flattenNestedBlock(in.Delivery); delivery != nil {
+		if err := d.Set("delivery", []interface{}{delivery}); err != nil {
 			derr = append(derr, diag.FromErr(err)[0])
 		}
 	}
@@ -335,38 +529,22 @@ func outgoingWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	// Handle custom webhook template attributes if changed
-	if d.HasChange("custom_webhook_template_attributes") {
-		if v, ok := d.GetOk("custom_webhook_template_attributes"); ok && len(v.([]interface{})) > 0 {
-			attrs := v.([]interface{})[0].(map[string]interface{})
-			template := &customWebhookTemplateAttributes{}
-
-			if method, ok := attrs["http_method"].(string); ok {
-				template.HTTPMethod = &method
-			}
-			if user, ok := attrs["auth_username"].(string); ok {
-				template.AuthUsername = &user
-			}
-			if pass, ok := attrs["auth_password"].(string); ok {
-				template.AuthPassword = &pass
-			}
-
-			if headers, ok := attrs["headers_template"].([]interface{}); ok {
-				template.HeaderTemplate = make([]headerTemplate, len(headers))
-				for i, h := range headers {
-					header := h.(map[string]interface{})
-					template.HeaderTemplate[i] = headerTemplate{
-						Name:  header["name"].(string),
-						Value: header["value"].(string),
-					}
-				}
-			}
-
-			if body, ok := attrs["body_template"].(string); ok {
-				template.BodyTemplate = body
-			}
+	// Handle delivery settings if changed. A non-nil struct is sent even if
+	// the block was removed entirely, so the API clears it instead of
+	// leaving the previous value in place.
+	if d.HasChange("delivery") {
+		in.Delivery = &webhookDelivery{}
+		if err := expandNestedBlock(d, "delivery", in.Delivery); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-			in.CustomWebhookTemplateAttributes = template
+	// Handle custom webhook template attributes if changed. Same rationale
+	// as delivery above: always send a (possibly empty) struct on change.
+	if d.HasChange("custom_webhook_template_attributes") {
+		in.CustomWebhookTemplateAttributes = &customWebhookTemplateAttributes{}
+		if err := expandNestedBlock(d, "custom_webhook_template_attributes", in.CustomWebhookTemplateAttributes); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
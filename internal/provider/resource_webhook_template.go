@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var webhookTemplateSchema = map[string]*schema.Schema{
+	"team_name": {
+		Description: "Used to specify the team the resource should be created in when using global tokens.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     nil,
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return d.Id() != ""
+		},
+	},
+	"id": {
+		Description: "The ID of the webhook template.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
+	"name": {
+		Description: "The name of the webhook template.",
+		Type:        schema.TypeString,
+		Required:    true,
+	},
+	"http_method": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "post",
+		Description: "The HTTP method to use when sending webhooks bound to this template. Possible values: `get`, `post`, `put`, `patch` and `head`.",
+	},
+	"headers_template": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The headers to include in the webhook request.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	},
+	"body_template": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: suppressEquivalentJSONDiffs,
+		Description:      "The body of the webhook request.",
+	},
+}
+
+type webhookTemplate struct {
+	ID             *string           `json:"id,omitempty"`
+	Name           *string           `json:"name,omitempty"`
+	HTTPMethod     *string           `json:"http_method,omitempty"`
+	HeaderTemplate *[]headerTemplate `json:"headers_template,omitempty"`
+	BodyTemplate   interface{}       `json:"body_template,omitempty"`
+	TeamName       *string           `json:"team_name,omitempty"`
+}
+
+type webhookTemplateHTTPResponse struct {
+	Data struct {
+		ID         string          `json:"id"`
+		Attributes webhookTemplate `json:"attributes"`
+	} `json:"data"`
+}
+
+func newWebhookTemplateResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: webhookTemplateCreate,
+		ReadContext:   webhookTemplateRead,
+		UpdateContext: webhookTemplateUpdate,
+		DeleteContext: webhookTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "https://betterstack.com/docs/uptime/api/webhook-templates/",
+		Schema:      webhookTemplateSchema,
+	}
+}
+
+func webhookTemplateRef(in *webhookTemplate) []struct {
+	k string
+	v interface{}
+} {
+	return []struct {
+		k string
+		v interface{}
+	}{
+		{k: "id", v: &in.ID},
+		{k: "name", v: &in.Name},
+		{k: "http_method", v: &in.HTTPMethod},
+		{k: "body_template", v: &in.BodyTemplate},
+	}
+}
+
+func webhookTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in webhookTemplate
+	for _, e := range webhookTemplateRef(&in) {
+		load(d, e.k, e.v)
+	}
+	load(d, "team_name", &in.TeamName)
+
+	if headers, ok := d.GetOk("headers_template"); ok {
+		expanded := expandHeaderTemplate(headers.([]interface{}))
+		in.HeaderTemplate = &expanded
+	}
+
+	var out webhookTemplateHTTPResponse
+	if err := resourceCreate(ctx, meta, "/api/v2/webhook-templates", &in, &out); err != nil {
+		return err
+	}
+
+	d.SetId(out.Data.ID)
+	return webhookTemplateCopyAttrs(d, &out.Data.Attributes)
+}
+
+func webhookTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var out webhookTemplateHTTPResponse
+	if err, ok := resourceRead(ctx, meta, fmt.Sprintf("/api/v2/webhook-templates/%s", url.PathEscape(d.Id())), &out); err != nil {
+		return err
+	} else if !ok {
+		d.SetId("")
+		return nil
+	}
+	return webhookTemplateCopyAttrs(d, &out.Data.Attributes)
+}
+
+func webhookTemplateCopyAttrs(d *schema.ResourceData, in *webhookTemplate) diag.Diagnostics {
+	var derr diag.Diagnostics
+	for _, e := range webhookTemplateRef(in) {
+		if err := d.Set(e.k, reflect.Indirect(reflect.ValueOf(e.v)).Interface()); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+
+	if in.HeaderTemplate != nil {
+		if err := d.Set("headers_template", flattenHeaderTemplate(*in.HeaderTemplate)); err != nil {
+			derr = append(derr, diag.FromErr(err)[0])
+		}
+	}
+
+	return derr
+}
+
+func webhookTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var in webhookTemplate
+	for _, e := range webhookTemplateRef(&in) {
+		if d.HasChange(e.k) {
+			load(d, e.k, e.v)
+		}
+	}
+
+	if d.HasChange("headers_template") {
+		// Always send an explicit (possibly empty) list on change: an empty
+		// slice is indistinguishable from "omitted" once JSON-marshaled with
+		// `omitempty`, so the pointer itself is what signals "clear these".
+		expanded := expandHeaderTemplate(d.Get("headers_template").([]interface{}))
+		in.HeaderTemplate = &expanded
+	}
+
+	var out webhookTemplateHTTPResponse
+	return resourceUpdate(ctx, meta, fmt.Sprintf("/api/v2/webhook-templates/%s", url.PathEscape(d.Id())), &in, &out)
+}
+
+func webhookTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDelete(ctx, meta, fmt.Sprintf("/api/v2/webhook-templates/%s", url.PathEscape(d.Id())))
+}
+
+func expandHeaderTemplate(in []interface{}) []headerTemplate {
+	out := make([]headerTemplate, len(in))
+	for i, h := range in {
+		header := h.(map[string]interface{})
+		out[i] = headerTemplate{
+			Name:  header["name"].(string),
+			Value: header["value"].(string),
+		}
+	}
+	return out
+}
+
+func flattenHeaderTemplate(in []headerTemplate) []map[string]interface{} {
+	out := make([]map[string]interface{}, len(in))
+	for i, h := range in {
+		out[i] = map[string]interface{}{
+			"name":  h.Name,
+			"value": h.Value,
+		}
+	}
+	return out
+}